@@ -0,0 +1,63 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlluxioRuntimeSpec defines the desired state of an AlluxioRuntime. The full spec (Replicas,
+// TieredStore, Master/Worker/Fuse pod templates, etc.) lives on the upstream type this snapshot
+// doesn't carry; nothing in this package reads Spec fields, so it is left empty here.
+type AlluxioRuntimeSpec struct {
+}
+
+// AlluxioRuntimeStatus defines the observed state of an AlluxioRuntime.
+type AlluxioRuntimeStatus struct {
+	// Conditions is an array of current observed runtime conditions.
+	// +optional
+	Conditions []RuntimeCondition `json:"conditions,omitempty"`
+
+	// MountTime is the last time UFSs were (re)mounted for this runtime.
+	// +optional
+	MountTime metav1.Time `json:"mountTime,omitempty"`
+
+	// MountPointStatuses reports the per-mount storage stats Fluid last observed from the
+	// engine, e.g. used/free/total storage bytes and file count for each non Fluid-native UFS
+	// mount point.
+	// +optional
+	MountPointStatuses []MountPointStatus `json:"mountPointStatuses,omitempty"`
+}
+
+// AlluxioRuntime is the Schema for the alluxioruntimes API.
+type AlluxioRuntime struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AlluxioRuntimeSpec   `json:"spec,omitempty"`
+	Status AlluxioRuntimeStatus `json:"status,omitempty"`
+}
+
+// AlluxioRuntimeList contains a list of AlluxioRuntime.
+type AlluxioRuntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AlluxioRuntime `json:"items"`
+}
+
+// DeepCopyObject and the rest of the runtime.Object/client.Object boilerplate for AlluxioRuntime
+// and AlluxioRuntimeList are produced by controller-gen into zz_generated.deepcopy.go (run `make
+// generate`) and are not hand-written here.
@@ -0,0 +1,73 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// EncryptOption is a single key/value pair a Mount wants to pass to the engine (e.g. as an
+// Alluxio mount option), with the value pulled from one of several sources instead of being
+// written in plaintext in the Dataset spec.
+type EncryptOption struct {
+	// Name is the option name, e.g. an Alluxio UFS option such as "fs.oss.accessKeyId".
+	Name string `json:"name"`
+
+	// ValueFrom specifies the source the option's value should be resolved from.
+	ValueFrom EncryptOptionSource `json:"valueFrom"`
+}
+
+// EncryptOptionSource specifies where an EncryptOption's value is resolved from. Exactly one
+// field should be set.
+type EncryptOptionSource struct {
+	// SecretKeyRef resolves the value from a key in a Kubernetes Secret.
+	// +optional
+	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// ConfigMapKeyRef resolves the value from a key in a Kubernetes ConfigMap. Intended for
+	// non-sensitive values that don't warrant a Secret.
+	// +optional
+	ConfigMapKeyRef *corev1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// FileRef resolves the value by reading a file from inside the Alluxio master pod, e.g. a
+	// credential projected into the pod's filesystem by a CSI driver.
+	// +optional
+	FileRef *FileKeySelector `json:"fileRef,omitempty"`
+
+	// ExternalRef resolves the value from a provider registered with
+	// credentials.RegisterExternalProvider, e.g. HashiCorp Vault or a cloud KMS.
+	// +optional
+	ExternalRef *ExternalCredentialRef `json:"externalRef,omitempty"`
+}
+
+// FileKeySelector selects a value by reading a file from inside a running pod.
+type FileKeySelector struct {
+	// Path is the absolute path of the file to read inside the pod.
+	Path string `json:"path"`
+}
+
+// ExternalCredentialRef selects a value from an out-of-cluster credential provider registered
+// under Provider with credentials.RegisterExternalProvider.
+type ExternalCredentialRef struct {
+	// Provider is the name the credential provider was registered under.
+	Provider string `json:"provider"`
+
+	// Path identifies the secret/credential within the provider, e.g. a Vault secret path.
+	Path string `json:"path"`
+
+	// Key selects a single field out of the value stored at Path.
+	Key string `json:"key"`
+}
@@ -0,0 +1,38 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// MountPointStatus describes the storage accounting Fluid has observed for a single UFS mount
+// point of a Dataset, as last reported by the engine (e.g. Alluxio's `fs count`/capacity report).
+type MountPointStatus struct {
+	// Name is the name of the Mount this status was computed for.
+	Name string `json:"name"`
+
+	// UfsPath is the path the UFS is mounted at inside the engine (e.g. the Alluxio path).
+	UfsPath string `json:"ufsPath"`
+
+	// UsedStorageBytes is the storage used by this mount point, in bytes.
+	UsedStorageBytes int64 `json:"usedStorageBytes"`
+
+	// FreeStorageBytes is the storage still available to this mount point, in bytes.
+	FreeStorageBytes int64 `json:"freeStorageBytes"`
+
+	// TotalStorageBytes is the total storage capacity backing this mount point, in bytes.
+	TotalStorageBytes int64 `json:"totalStorageBytes"`
+
+	// FileCount is the number of files found under this mount point.
+	FileCount int64 `json:"fileCount"`
+}
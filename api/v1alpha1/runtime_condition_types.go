@@ -0,0 +1,55 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RuntimeConditionType is a valid value for RuntimeCondition.Type.
+type RuntimeConditionType string
+
+const (
+	// RuntimeUFSMounted means the runtime has successfully mounted every configured UFS.
+	RuntimeUFSMounted RuntimeConditionType = "UFSMounted"
+)
+
+// RuntimeCondition describes the current observed state of one aspect of a runtime, following the
+// same shape Kubernetes uses for Pod/Node conditions.
+type RuntimeCondition struct {
+	// Type of runtime condition.
+	Type RuntimeConditionType `json:"type"`
+
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+
+	// Reason is a brief machine-readable explanation for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable description of the details of the last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastUpdateTime is the last time this condition was updated.
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// LastTransitionTime is the last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
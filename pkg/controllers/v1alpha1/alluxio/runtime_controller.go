@@ -0,0 +1,96 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package alluxio contains the controller-runtime controller for AlluxioRuntime. The actual
+// engine-level reconciliation (mounting UFSs, syncing metadata, updating status, etc.) lives in
+// pkg/ddc/alluxio; this package is only responsible for turning Kubernetes events into reconcile
+// requests and invoking that engine.
+package alluxio
+
+import (
+	"context"
+	"sync"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	alluxioengine "github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// RuntimeReconciler reconciles an AlluxioRuntime.
+type RuntimeReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Recorder record.EventRecorder
+
+	observedMountsMu sync.Mutex
+	observedMounts   map[types.NamespacedName][]datav1alpha1.Mount
+}
+
+// Reconcile fetches the AlluxioRuntime named in req and asks the engine package to bring
+// Alluxio's mounted UFSs in line with the Dataset's current spec, passing along the mount set
+// observed the last time this runtime was reconciled so the engine can diff against it. A Secret
+// rotation is caught by SyncUFS's live drift check, not by that diff.
+func (r *RuntimeReconciler) Reconcile(ctx context.Context, req reconcile.Request) (ctrl.Result, error) {
+	runtime := &datav1alpha1.AlluxioRuntime{}
+	if err := r.Get(ctx, req.NamespacedName, runtime); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	r.Log.Info("reconciling AlluxioRuntime", "namespace", req.Namespace, "name", req.Name)
+
+	engine := alluxioengine.NewAlluxioEngine(r.Client, r.Recorder, r.Log, req.Name, req.Namespace)
+
+	newMounts, err := engine.SyncUFS(r.getObservedMounts(req.NamespacedName))
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	r.setObservedMounts(req.NamespacedName, newMounts)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *RuntimeReconciler) getObservedMounts(name types.NamespacedName) []datav1alpha1.Mount {
+	r.observedMountsMu.Lock()
+	defer r.observedMountsMu.Unlock()
+	return r.observedMounts[name]
+}
+
+func (r *RuntimeReconciler) setObservedMounts(name types.NamespacedName, mounts []datav1alpha1.Mount) {
+	r.observedMountsMu.Lock()
+	defer r.observedMountsMu.Unlock()
+	if r.observedMounts == nil {
+		r.observedMounts = map[types.NamespacedName][]datav1alpha1.Mount{}
+	}
+	r.observedMounts[name] = mounts
+}
+
+// SetupWithManager registers RuntimeReconciler with mgr, reconciling on AlluxioRuntime changes and
+// on Secret changes that rotate a credential referenced by one of its Datasets' EncryptOptions.
+func (r *RuntimeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.Recorder = mgr.GetEventRecorderFor("alluxioruntime-controller")
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&datav1alpha1.AlluxioRuntime{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(alluxioengine.EncryptSecretToDatasetMapFunc(mgr.GetClient()))).
+		Complete(r)
+}
@@ -0,0 +1,36 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveConfigMapKeyRef reads a value from a ConfigMap. It exists alongside SecretKeyRef for
+// options that are not sensitive and don't warrant a Secret, e.g. a non-secret endpoint URL or
+// region name that an operator still wants to manage outside the Dataset spec.
+func resolveConfigMapKeyRef(c client.Client, namespace string, ref *corev1.ConfigMapKeySelector) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: ref.Name}, configMap); err != nil {
+		return "", err
+	}
+
+	return configMap.Data[ref.Key], nil
+}
@@ -0,0 +1,56 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ExternalProvider fetches a credential from a backend that isn't natively modeled by
+// Kubernetes, such as HashiCorp Vault or a cloud KMS.
+type ExternalProvider interface {
+	// Fetch returns the plaintext value stored at path under key.
+	Fetch(ctx context.Context, path string, key string) (string, error)
+}
+
+var (
+	externalProvidersMu sync.RWMutex
+	externalProviders   = map[string]ExternalProvider{}
+)
+
+// RegisterExternalProvider registers provider under name so that an EncryptOptions entry with
+// ValueFrom.ExternalRef.Provider == name resolves through it. Provider implementations should
+// call this from their own package's init(); fluid does not ship any built-in provider.
+func RegisterExternalProvider(name string, provider ExternalProvider) {
+	externalProvidersMu.Lock()
+	defer externalProvidersMu.Unlock()
+	externalProviders[name] = provider
+}
+
+func resolveExternalRef(ctx context.Context, ref *datav1alpha1.ExternalCredentialRef) (string, error) {
+	externalProvidersMu.RLock()
+	provider, ok := externalProviders[ref.Provider]
+	externalProvidersMu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("no credential provider registered for %q, did you forget to import it?", ref.Provider)
+	}
+
+	return provider.Fetch(ctx, ref.Path, ref.Key)
+}
@@ -0,0 +1,36 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// resolveFileRef reads a value projected as a file into the Alluxio master pod, e.g. by a CSI
+// driver that mounts secrets from an external secret store as pod volumes. The value is read
+// through podFileReader rather than the Kubernetes API because the file only exists inside the
+// pod's filesystem.
+func resolveFileRef(ctx context.Context, podFileReader PodFileReader, ref *datav1alpha1.FileKeySelector) (string, error) {
+	content, err := podFileReader.ReadFile(ctx, ref.Path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(content, "\n"), nil
+}
@@ -0,0 +1,33 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// resolveSecretKeyRef reads a value from a Kubernetes Secret, the original and still most common
+// EncryptOptions source.
+func resolveSecretKeyRef(c client.Client, namespace string, ref *corev1.SecretKeySelector) (string, error) {
+	secret, err := kubeclient.GetSecret(c, ref.Name, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret.Data[ref.Key]), nil
+}
@@ -0,0 +1,179 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operations wraps `alluxio` CLI commands run inside the Alluxio master pod, giving
+// engine code a typed Go API instead of parsing `kubectl exec` output inline.
+package operations
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"github.com/go-logr/logr"
+)
+
+// AlluxioFileUtils wraps shell commands run against the `alluxio` CLI inside the Alluxio master
+// pod.
+type AlluxioFileUtils struct {
+	podName       string
+	containerName string
+	namespace     string
+	log           logr.Logger
+}
+
+// NewAlluxioFileUtils returns an AlluxioFileUtils bound to the given master pod/container.
+func NewAlluxioFileUtils(podName string, containerName string, namespace string, log logr.Logger) AlluxioFileUtils {
+	return AlluxioFileUtils{
+		podName:       podName,
+		containerName: containerName,
+		namespace:     namespace,
+		log:           log,
+	}
+}
+
+// exec runs a command inside the master pod and returns its stdout.
+func (a AlluxioFileUtils) exec(args []string) (stdout string, err error) {
+	stdout, stderr, err := kubeclient.ExecCommandInContainer(a.podName, a.containerName, a.namespace, args)
+	if err != nil {
+		a.log.Info("exec command in alluxio master failed", "args", args, "stdout", stdout, "stderr", stderr, "err", err)
+		return stdout, err
+	}
+
+	return stdout, nil
+}
+
+// Ready reports whether the Alluxio master is up and able to serve `alluxio fs` commands.
+func (a AlluxioFileUtils) Ready() bool {
+	_, err := a.exec([]string{"alluxio", "fsadmin", "report"})
+	return err == nil
+}
+
+// IsMounted reports whether alluxioPath is currently mounted to a UFS.
+func (a AlluxioFileUtils) IsMounted(alluxioPath string) (bool, error) {
+	stdout, err := a.exec([]string{"alluxio", "fs", "mount"})
+	if err != nil {
+		return false, err
+	}
+
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && fields[0] == alluxioPath {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Mount mounts ufsPath at alluxioPath with the given per-mount options and access mode.
+func (a AlluxioFileUtils) Mount(alluxioPath string, ufsPath string, options map[string]string, readOnly bool, shared bool) error {
+	args := []string{"alluxio", "fs", "mount"}
+	if readOnly {
+		args = append(args, "--readonly")
+	}
+	if shared {
+		args = append(args, "--shared")
+	}
+	for k, v := range options {
+		args = append(args, "--option", fmt.Sprintf("%s=%s", k, v))
+	}
+	args = append(args, alluxioPath, ufsPath)
+
+	_, err := a.exec(args)
+	return err
+}
+
+// ReadFile reads the content of a file inside the master pod at path. It backs
+// credentials.PodFileReader, used to resolve EncryptOptions.ValueFrom.FileRef sources that are
+// projected into the pod's filesystem rather than the Kubernetes API (e.g. by a CSI driver).
+func (a AlluxioFileUtils) ReadFile(ctx context.Context, path string) (string, error) {
+	return a.exec([]string{"cat", path})
+}
+
+// UnMount unmounts alluxioPath.
+func (a AlluxioFileUtils) UnMount(alluxioPath string) error {
+	_, err := a.exec([]string{"alluxio", "fs", "unmount", alluxioPath})
+	return err
+}
+
+// FindUnmountedAlluxioPaths returns the subset of alluxioPaths that are not currently mounted.
+func (a AlluxioFileUtils) FindUnmountedAlluxioPaths(alluxioPaths []string) ([]string, error) {
+	stdout, err := a.exec([]string{"alluxio", "fs", "mount"})
+	if err != nil {
+		return nil, err
+	}
+
+	mounted := map[string]bool{}
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 {
+			mounted[fields[0]] = true
+		}
+	}
+
+	var unmounted []string
+	for _, p := range alluxioPaths {
+		if !mounted[p] {
+			unmounted = append(unmounted, p)
+		}
+	}
+
+	return unmounted, nil
+}
+
+// GetFileCount returns the total number of files under the Alluxio root.
+func (a AlluxioFileUtils) GetFileCount() (int64, error) {
+	fileCount, _, _, err := a.Count("/")
+	return fileCount, err
+}
+
+// Count returns the file count, folder count and total size in bytes under path.
+func (a AlluxioFileUtils) Count(path string) (fileCount int64, folderCount int64, sizeBytes int64, err error) {
+	stdout, err := a.exec([]string{"alluxio", "fs", "count", path})
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) < 2 {
+		return 0, 0, 0, fmt.Errorf("unexpected output from `alluxio fs count %s`: %q", path, stdout)
+	}
+
+	// The last line holds the values; the first is a header ("File Count  Folder Count  Total Size").
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) < 3 {
+		return 0, 0, 0, fmt.Errorf("unexpected output from `alluxio fs count %s`: %q", path, stdout)
+	}
+
+	fileCount, err = strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	folderCount, err = strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	sizeBytes, err = strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return fileCount, folderCount, sizeBytes, nil
+}
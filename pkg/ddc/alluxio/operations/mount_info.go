@@ -0,0 +1,103 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MountInfo describes how Alluxio currently has a UFS mounted, as reported by `alluxio fs mount`.
+type MountInfo struct {
+	// UfsPath is the underlying storage path the mount points at.
+	UfsPath string
+
+	// ReadOnly reports whether the mount was created with --readonly.
+	ReadOnly bool
+
+	// Shared reports whether the mount was created with --shared.
+	Shared bool
+
+	// Properties holds the per-mount options the mount was created with, i.e. every
+	// `--option key=value` passed to `alluxio fs mount`.
+	Properties map[string]string
+}
+
+// GetMountInfo returns how alluxioPath is currently mounted, so callers can detect drift against
+// the options Fluid currently wants to apply.
+func (a AlluxioFileUtils) GetMountInfo(alluxioPath string) (info MountInfo, err error) {
+	stdout, err := a.exec([]string{"alluxio", "fs", "mount"})
+	if err != nil {
+		return info, err
+	}
+
+	for _, line := range strings.Split(stdout, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != alluxioPath {
+			continue
+		}
+
+		info.UfsPath = fields[1]
+		info.Properties = map[string]string{}
+
+		for i := 2; i < len(fields); i++ {
+			field := fields[i]
+			switch {
+			case field == "readonly":
+				info.ReadOnly = true
+			case field == "shared":
+				info.Shared = true
+			case strings.HasPrefix(field, "properties="):
+				// The blob contains ", " between pairs, so rejoin the fields up to its closing brace.
+				end := i
+				for end < len(fields)-1 && !strings.HasSuffix(fields[end], "}") {
+					end++
+				}
+				blob := strings.Join(fields[i:end+1], " ")
+				parseMountProperties(strings.TrimPrefix(blob, "properties="), info.Properties)
+				i = end
+			}
+		}
+
+		return info, nil
+	}
+
+	return info, fmt.Errorf("alluxioPath %s is not mounted", alluxioPath)
+}
+
+// parseMountProperties parses the `{key=value, key2=value2}` properties blob `alluxio fs mount`
+// prints for a mount's options into dst.
+func parseMountProperties(blob string, dst map[string]string) {
+	blob = strings.TrimPrefix(blob, "{")
+	blob = strings.TrimSuffix(blob, "}")
+	if blob == "" {
+		return
+	}
+
+	for _, pair := range strings.Split(blob, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+
+		dst[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+	}
+}
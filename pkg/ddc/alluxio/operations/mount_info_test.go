@@ -0,0 +1,58 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMountProperties(t *testing.T) {
+	tests := []struct {
+		name string
+		blob string
+		want map[string]string
+	}{
+		{
+			name: "empty",
+			blob: "{}",
+			want: map[string]string{},
+		},
+		{
+			name: "single property",
+			blob: "{fs.oss.endpoint=oss.example.com}",
+			want: map[string]string{"fs.oss.endpoint": "oss.example.com"},
+		},
+		{
+			name: "multiple properties with spacing",
+			blob: "{fs.oss.endpoint=oss.example.com, fs.oss.accessKeyId=AKID}",
+			want: map[string]string{
+				"fs.oss.endpoint":    "oss.example.com",
+				"fs.oss.accessKeyId": "AKID",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := map[string]string{}
+			parseMountProperties(tt.blob, got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseMountProperties(%q) = %v, want %v", tt.blob, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// bytesPerUnit lists the unit suffixes `alluxio fsadmin report capacity` and `alluxio fs du` print
+// (binary units, as Alluxio's own FormatUtils does), longest first so e.g. "KB" is matched before
+// the trailing "B" every other unit also ends with.
+var bytesPerUnit = []struct {
+	unit       string
+	multiplier float64
+}{
+	{"PB", 1 << 50},
+	{"TB", 1 << 40},
+	{"GB", 1 << 30},
+	{"MB", 1 << 20},
+	{"KB", 1 << 10},
+	{"B", 1},
+}
+
+// GetUFSCapacityBytes returns the storage bytes used under alluxioPath (via `alluxio fs du`).
+func (a AlluxioFileUtils) GetUFSCapacityBytes(alluxioPath string) (used int64, err error) {
+	duStdout, err := a.exec([]string{"alluxio", "fs", "du", "-s", alluxioPath})
+	if err != nil {
+		return 0, err
+	}
+
+	used, err = parseDuBytes(duStdout)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse `alluxio fs du -s %s` output %q: %w", alluxioPath, duStdout, err)
+	}
+
+	return used, nil
+}
+
+// GetClusterCapacityBytes returns Alluxio's total and free storage capacity. Unlike
+// GetUFSCapacityBytes, this is not specific to any one UFS mount: Alluxio's storage capacity is
+// shared across every mount, so callers with more than one mount point should call this once per
+// cycle rather than once per mount.
+func (a AlluxioFileUtils) GetClusterCapacityBytes() (total int64, free int64, err error) {
+	stdout, err := a.exec([]string{"alluxio", "fsadmin", "report", "capacity"})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	total, err = parseCapacityReportField(stdout, "Total Capacity:")
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to parse `alluxio fsadmin report capacity` output %q: %w", stdout, err)
+	}
+
+	free, err = parseCapacityReportField(stdout, "Free Capacity:")
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to parse `alluxio fsadmin report capacity` output %q: %w", stdout, err)
+	}
+
+	return total, free, nil
+}
+
+// parseDuBytes parses the byte count off the last line of `alluxio fs du -s <path>` output,
+// e.g. "1234           /mnt/foo".
+func parseDuBytes(output string) (int64, error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) == 0 {
+		return 0, fmt.Errorf("empty output")
+	}
+
+	fields := strings.Fields(lines[len(lines)-1])
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("no fields in line %q", lines[len(lines)-1])
+	}
+
+	return strconv.ParseInt(fields[0], 10, 64)
+}
+
+// parseCapacityReportField extracts the trailing size off the `alluxio fsadmin report capacity`
+// line starting with label, e.g. "Free Capacity: 9.00GB", and converts it to bytes.
+func parseCapacityReportField(output string, label string) (int64, error) {
+	for _, line := range strings.Split(output, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, label) {
+			continue
+		}
+
+		fields := strings.Fields(trimmed)
+		if len(fields) == 0 {
+			return 0, fmt.Errorf("no fields in line %q", trimmed)
+		}
+
+		return parseHumanReadableBytes(fields[len(fields)-1])
+	}
+
+	return 0, fmt.Errorf("label %q not found", label)
+}
+
+// parseHumanReadableBytes parses a size formatted the way Alluxio's CLI prints it, e.g. "9.00GB",
+// "512.00KB" or a unit-less raw byte count, into a number of bytes.
+func parseHumanReadableBytes(s string) (int64, error) {
+	for _, u := range bytesPerUnit {
+		if !strings.HasSuffix(s, u.unit) {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSuffix(s, u.unit), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid size %q: %w", s, err)
+		}
+
+		return int64(value * u.multiplier), nil
+	}
+
+	return strconv.ParseInt(s, 10, 64)
+}
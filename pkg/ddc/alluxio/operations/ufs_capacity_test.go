@@ -0,0 +1,114 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operations
+
+import "testing"
+
+func TestParseDuBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    int64
+		wantErr bool
+	}{
+		{
+			name:   "single path",
+			output: "1234           /mnt/foo\n",
+			want:   1234,
+		},
+		{
+			name:   "header line then totals, as `du -s` prints",
+			output: "File Size     In Alluxio       Path\n5566277       5566277          /mnt/foo\n",
+			want:   5566277,
+		},
+		{
+			name:    "empty output",
+			output:  "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDuBytes(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDuBytes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("parseDuBytes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCapacityReportField(t *testing.T) {
+	// Sample trimmed from real `alluxio fsadmin report capacity` output.
+	const output = `Capacity information for all workers:
+    Total Capacity: 10.00GB
+        Tier: MEM  Size: 10.00GB
+    Used Capacity: 1.00GB
+        Tier: MEM  Size: 1.00GB
+    Free Capacity: 9.00GB
+`
+
+	tests := []struct {
+		name    string
+		label   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "free capacity in GB", label: "Free Capacity:", want: 9 * (1 << 30)},
+		{name: "total capacity in GB", label: "Total Capacity:", want: 10 * (1 << 30)},
+		{name: "label not present", label: "Nonexistent:", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCapacityReportField(output, tt.label)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCapacityReportField() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("parseCapacityReportField() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseHumanReadableBytes(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"9.00GB", 9 * (1 << 30)},
+		{"512.00KB", 512 * (1 << 10)},
+		{"1.00TB", 1 << 40},
+		{"100B", 100},
+		{"1234", 1234},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseHumanReadableBytes(tt.input)
+			if err != nil {
+				t.Fatalf("parseHumanReadableBytes(%q) returned error: %v", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("parseHumanReadableBytes(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewAlluxioEngine returns an AlluxioEngine bound to the AlluxioRuntime/Dataset named name in
+// namespace, for use by the controller's reconcile loop.
+func NewAlluxioEngine(c client.Client, recorder record.EventRecorder, log logr.Logger, name string, namespace string) *AlluxioEngine {
+	return &AlluxioEngine{
+		name:      name,
+		namespace: namespace,
+		Client:    c,
+		Log:       log,
+		Recorder:  recorder,
+	}
+}
+
+// SyncUFS reconciles Alluxio's mounted UFSs against the dataset's current spec. oldMounts is the
+// Mount set observed the last time SyncUFS ran for this dataset (e.g. read off a status field or
+// cache by the caller); passing nil is safe and simply treats every current mount as new.
+//
+// It mounts any UFS that has never been mounted, then — independent of whatever BuildUFSToUpdate
+// computed from the oldMounts/newMounts diff — re-checks every already-mounted UFS against what
+// Alluxio currently reports and remounts it if it drifted. That second pass is what catches a
+// Secret rotation: the Mount spec referencing the Secret never changes, so the diff alone could
+// never flag it, but processUpdatingUFS's drift check compares freshly resolved option values
+// (which do change) against Alluxio's live mount info regardless of what the diff found.
+func (e *AlluxioEngine) SyncUFS(oldMounts []datav1alpha1.Mount) (newMounts []datav1alpha1.Mount, err error) {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return nil, err
+	}
+	newMounts = dataset.Spec.Mounts
+
+	should, err := e.shouldMountUFS()
+	if err != nil {
+		return newMounts, err
+	}
+	if should {
+		if err := e.mountUFS(); err != nil {
+			return newMounts, err
+		}
+	}
+
+	ufsToUpdate := utils.BuildUFSToUpdate(oldMounts, newMounts, func(m datav1alpha1.Mount, mounts []datav1alpha1.Mount) string {
+		return utils.UFSPathBuilder{}.GenAlluxioMountPath(m, mounts)
+	})
+
+	return newMounts, e.processUpdatingUFS(ufsToUpdate)
+}
@@ -0,0 +1,78 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"context"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// EncryptSecretToDatasetMapFunc returns a handler.MapFunc suitable for
+// handler.EnqueueRequestsFromMapFunc, enqueuing a reconcile request for every Dataset in the
+// Secret's namespace whose Mount.EncryptOptions references that Secret. Wiring this into the
+// AlluxioRuntime controller's SetupWithManager lets a rotated credential (e.g. a Secret
+// re-created by an external secret operator) trigger processUpdatingUFS's drift detection
+// without waiting for the Dataset itself to be edited, mirroring the mount-pod reload flow the
+// JuiceFS CSI driver added for Secret/ConfigMap recreation.
+func EncryptSecretToDatasetMapFunc(c client.Client) func(client.Object) []reconcile.Request {
+	return func(obj client.Object) []reconcile.Request {
+		secret, ok := obj.(*corev1.Secret)
+		if !ok {
+			return nil
+		}
+
+		var datasets datav1alpha1.DatasetList
+		if err := c.List(context.Background(), &datasets, client.InNamespace(secret.GetNamespace())); err != nil {
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, dataset := range datasets.Items {
+			if !datasetReferencesSecret(dataset, secret.GetName()) {
+				continue
+			}
+
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: dataset.GetNamespace(),
+					Name:      dataset.GetName(),
+				},
+			})
+		}
+
+		return requests
+	}
+}
+
+// datasetReferencesSecret reports whether any Mount.EncryptOptions on the dataset resolves its
+// value from the given Secret.
+func datasetReferencesSecret(dataset datav1alpha1.Dataset, secretName string) bool {
+	for _, mount := range dataset.Spec.Mounts {
+		for _, encryptOption := range mount.EncryptOptions {
+			secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
+			if secretKeyRef != nil && secretKeyRef.Name == secretName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
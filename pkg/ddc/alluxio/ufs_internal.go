@@ -19,36 +19,230 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
-	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/retry"
 )
 
+// mountPointStorageStatsCacheTTL bounds how stale a cached mountPointStorageStats() or
+// clusterCapacityBytes() result can be. usedStorageBytesInternal, freeStorageBytesInternal and
+// totalStorageBytesInternal are called back-to-back on every status-sync cycle, so without a cache
+// each one would independently re-exec into the master pod.
+const mountPointStorageStatsCacheTTL = 30 * time.Second
+
+type mountPointStorageStatsCacheEntry struct {
+	stats    []datav1alpha1.MountPointStatus
+	computed time.Time
+}
+
+var (
+	mountPointStorageStatsCacheMu sync.Mutex
+	mountPointStorageStatsCache   = map[string]mountPointStorageStatsCacheEntry{}
+)
+
+type clusterCapacityCacheEntry struct {
+	total    int64
+	free     int64
+	computed time.Time
+}
+
+var (
+	clusterCapacityCacheMu sync.Mutex
+	clusterCapacityCache   = map[string]clusterCapacityCacheEntry{}
+)
+
+var (
+	mountPointUsedStorageBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fluid_alluxio_mount_point_used_storage_bytes",
+		Help: "Used storage bytes of a single UFS mount point as seen by Alluxio",
+	}, []string{"runtime", "namespace", "mount"})
+
+	mountPointFreeStorageBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fluid_alluxio_mount_point_free_storage_bytes",
+		Help: "Free storage bytes of a single UFS mount point as seen by Alluxio",
+	}, []string{"runtime", "namespace", "mount"})
+
+	mountPointTotalStorageBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fluid_alluxio_mount_point_total_storage_bytes",
+		Help: "Total storage bytes of a single UFS mount point as seen by Alluxio",
+	}, []string{"runtime", "namespace", "mount"})
+
+	mountPointFileCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fluid_alluxio_mount_point_file_count",
+		Help: "File count of a single UFS mount point as seen by Alluxio",
+	}, []string{"runtime", "namespace", "mount"})
+)
+
+func init() {
+	prometheus.MustRegister(mountPointUsedStorageBytes, mountPointFreeStorageBytes, mountPointTotalStorageBytes, mountPointFileCount)
+}
+
 func (e *AlluxioEngine) usedStorageBytesInternal() (value int64, err error) {
+	stats, err := e.mountPointStorageStats()
+	if err != nil {
+		return value, err
+	}
+
+	for _, stat := range stats {
+		value += stat.UsedStorageBytes
+	}
+
 	return
 }
 
+// freeStorageBytesInternal returns Alluxio's free storage capacity. This is a single cluster-wide
+// figure rather than a per-mount one, so unlike usedStorageBytesInternal it must not be summed
+// across mount points.
 func (e *AlluxioEngine) freeStorageBytesInternal() (value int64, err error) {
-	return
+	_, value, err = e.clusterCapacityBytes()
+	return value, err
 }
 
+// totalStorageBytesInternal returns Alluxio's total storage capacity. This is a single
+// cluster-wide figure rather than a per-mount one, so unlike usedStorageBytesInternal it must not
+// be summed across mount points.
 func (e *AlluxioEngine) totalStorageBytesInternal() (total int64, err error) {
+	total, _, err = e.clusterCapacityBytes()
+	return total, err
+}
+
+// mountPointStorageStats returns the per-mount storage stats for the dataset, computing them at
+// most once every mountPointStorageStatsCacheTTL, so usedStorageBytesInternal and
+// syncMountPointStats share one walk of the mount points. freeStorageBytesInternal and
+// totalStorageBytesInternal do not go through it; they read clusterCapacityBytes directly.
+func (e *AlluxioEngine) mountPointStorageStats() (stats []datav1alpha1.MountPointStatus, err error) {
+	cacheKey := e.namespace + "/" + e.name
+
+	mountPointStorageStatsCacheMu.Lock()
+	entry, ok := mountPointStorageStatsCache[cacheKey]
+	mountPointStorageStatsCacheMu.Unlock()
+	if ok && time.Since(entry.computed) < mountPointStorageStatsCacheTTL {
+		return entry.stats, nil
+	}
+
+	stats, err = e.computeMountPointStorageStats()
+	if err != nil {
+		return stats, err
+	}
+
+	mountPointStorageStatsCacheMu.Lock()
+	mountPointStorageStatsCache[cacheKey] = mountPointStorageStatsCacheEntry{stats: stats, computed: time.Now()}
+	mountPointStorageStatsCacheMu.Unlock()
+
+	return stats, nil
+}
+
+// clusterCapacityBytes returns Alluxio's total/free storage capacity, computing it at most once
+// every mountPointStorageStatsCacheTTL. This is a single cluster-wide figure, not a per-mount one,
+// so computeMountPointStorageStats must only fetch it once per cycle rather than once per mount.
+func (e *AlluxioEngine) clusterCapacityBytes() (total int64, free int64, err error) {
+	cacheKey := e.namespace + "/" + e.name
+
+	clusterCapacityCacheMu.Lock()
+	entry, ok := clusterCapacityCache[cacheKey]
+	clusterCapacityCacheMu.Unlock()
+	if ok && time.Since(entry.computed) < mountPointStorageStatsCacheTTL {
+		return entry.total, entry.free, nil
+	}
+
 	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
 
-	fileUitls := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
-	_, _, total, err = fileUitls.Count("/")
+	total, free, err = fileUtils.GetClusterCapacityBytes()
 	if err != nil {
-		return
+		return 0, 0, err
 	}
 
-	return
+	clusterCapacityCacheMu.Lock()
+	clusterCapacityCache[cacheKey] = clusterCapacityCacheEntry{total: total, free: free, computed: time.Now()}
+	clusterCapacityCacheMu.Unlock()
+
+	return total, free, nil
+}
+
+// computeMountPointStorageStats walks every non Fluid-native UFS mount point registered on the
+// dataset and asks Alluxio for the storage bytes used and file count rooted at that mount's
+// Alluxio path, plus the cluster-wide free/total capacity fetched once for the whole dataset. It
+// also refreshes the per-mount Prometheus gauges so operators can tell which mount inside a
+// multi-mount dataset is consuming capacity.
+func (e *AlluxioEngine) computeMountPointStorageStats() (stats []datav1alpha1.MountPointStatus, err error) {
+	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+	if err != nil {
+		return stats, err
+	}
+
+	total, free, err := e.clusterCapacityBytes()
+	if err != nil {
+		return stats, errors.Wrap(err, "failed to get cluster capacity")
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+
+	for _, mount := range dataset.Spec.Mounts {
+		if common.IsFluidNativeScheme(mount.MountPoint) {
+			// No storage accounting for a mount point with Fluid native scheme('local://' and 'pvc://')
+			continue
+		}
+
+		alluxioPath := utils.UFSPathBuilder{}.GenAlluxioMountPath(mount, dataset.Spec.Mounts)
+
+		fileCount, _, used, err := fileUtils.Count(alluxioPath)
+		if err != nil {
+			return stats, errors.Wrapf(err, "failed to count ufs usage, mount name: %s, alluxioPath: %s", mount.Name, alluxioPath)
+		}
+
+		stats = append(stats, datav1alpha1.MountPointStatus{
+			Name:              mount.Name,
+			UfsPath:           alluxioPath,
+			UsedStorageBytes:  used,
+			FreeStorageBytes:  free,
+			TotalStorageBytes: total,
+			FileCount:         fileCount,
+		})
+
+		mountPointUsedStorageBytes.WithLabelValues(e.name, e.namespace, mount.Name).Set(float64(used))
+		mountPointFreeStorageBytes.WithLabelValues(e.name, e.namespace, mount.Name).Set(float64(free))
+		mountPointTotalStorageBytes.WithLabelValues(e.name, e.namespace, mount.Name).Set(float64(total))
+		mountPointFileCount.WithLabelValues(e.name, e.namespace, mount.Name).Set(float64(fileCount))
+	}
+
+	return stats, nil
+}
+
+// syncMountPointStats recomputes per-mount storage statistics and records them on
+// AlluxioRuntime.Status.MountPointStatuses so that users can inspect them with `kubectl describe`.
+func (e *AlluxioEngine) syncMountPointStats() error {
+	stats, err := e.mountPointStorageStats()
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+
+		runtimeToUpdate := runtime.DeepCopy()
+		runtimeToUpdate.Status.MountPointStatuses = stats
+
+		if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
+			return e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+		}
+
+		return nil
+	})
 }
 
 func (e *AlluxioEngine) totalFileNumsInternal() (fileCount int64, err error) {
@@ -154,7 +348,16 @@ func (e *AlluxioEngine) processUpdatingUFS(ufsToUpdate *utils.UFSToUpdate) (err
 	}
 
 	everMounted := false
-	// Iterate all the mount points, do mount if the mount point is in added array
+	// Iterate all the mount points, do mount if the mount point is in added array and not yet
+	// mounted, or remount it if its options/credentials have drifted from what Alluxio currently
+	// has mounted.
+	//
+	// The drift check below runs for every mount that is already mounted, regardless of whether
+	// ufsToUpdate.ToUpdate() names it: ToUpdate() is built from the Dataset spec diff, so it can
+	// only ever see a change to Mount.Options/ReadOnly/Shared/EncryptOptions itself. A rotated
+	// Secret a Mount's EncryptOptions already pointed at changes the *resolved* option value
+	// without changing the Mount spec at all, so catching it means comparing against what
+	// Alluxio currently has mounted every time, not just when the spec diff flagged this path.
 	// TODO: not allow to edit FluidNativeScheme MountPoint
 	for _, mount := range dataset.Spec.Mounts {
 		if common.IsFluidNativeScheme(mount.MountPoint) {
@@ -162,40 +365,57 @@ func (e *AlluxioEngine) processUpdatingUFS(ufsToUpdate *utils.UFSToUpdate) (err
 		}
 
 		alluxioPath := utils.UFSPathBuilder{}.GenAlluxioMountPath(mount, dataset.Spec.Mounts)
-		if len(ufsToUpdate.ToAdd()) > 0 && utils.ContainsString(ufsToUpdate.ToAdd(), alluxioPath) {
-			mountOptions := map[string]string{}
-			for key, value := range mount.Options {
-				mountOptions[key] = value
+
+		mounted, err := fileUtils.IsMounted(alluxioPath)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case !mounted && utils.ContainsString(ufsToUpdate.ToAdd(), alluxioPath):
+			mountOptions, err := e.genUFSMountOptions(mount)
+			if err != nil {
+				return err
 			}
 
-			// Configure mountOptions using encryptOptions
-			// If encryptOptions have the same key with options, it will overwrite the corresponding value
-			for _, encryptOption := range mount.EncryptOptions {
-				key := encryptOption.Name
-				secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
-
-				secret, err := kubeclient.GetSecret(e.Client, secretKeyRef.Name, e.namespace)
-				if err != nil {
-					e.Log.Info("can't get the secret",
-						"namespace", e.namespace,
-						"name", e.name,
-						"secretName", secretKeyRef.Name)
-					return err
-				}
+			err = fileUtils.Mount(alluxioPath, mount.MountPoint, mountOptions, mount.ReadOnly, mount.Shared)
+			if err != nil {
+				return err
+			}
 
-				value := secret.Data[secretKeyRef.Key]
-				e.Log.Info("get value from secret",
-					"namespace", e.namespace,
-					"name", e.name,
-					"secretName", secretKeyRef.Name)
+			everMounted = true
+		default:
+			if !mounted {
+				// Not yet mounted and not in ToAdd(): shouldMountUFS()/mountUFS() owns bringing
+				// up mounts that were never seen before; nothing to drift-check yet.
+				continue
+			}
 
-				mountOptions[key] = string(value)
+			mountOptions, err := e.genUFSMountOptions(mount)
+			if err != nil {
+				return err
 			}
-			err = fileUtils.Mount(alluxioPath, mount.MountPoint, mountOptions, mount.ReadOnly, mount.Shared)
+
+			drifted, err := e.mountOptionsDrifted(fileUtils, alluxioPath, mount, mountOptions)
 			if err != nil {
 				return err
 			}
 
+			if !drifted {
+				continue
+			}
+
+			e.Log.Info("Detected option/credential drift on a mounted UFS, remounting",
+				"namespace", e.namespace, "name", e.name, "mount", mount.Name, "alluxioPath", alluxioPath)
+
+			if err := fileUtils.UnMount(alluxioPath); err != nil {
+				return errors.Wrapf(err, "failed to unmount drifted ufs before remount, mount name:%s", mount.Name)
+			}
+
+			if err := fileUtils.Mount(alluxioPath, mount.MountPoint, mountOptions, mount.ReadOnly, mount.Shared); err != nil {
+				return errors.Wrapf(err, "failed to remount drifted ufs, mount name:%s", mount.Name)
+			}
+
 			everMounted = true
 		}
 	}
@@ -230,10 +450,29 @@ func (e *AlluxioEngine) processUpdatingUFS(ufsToUpdate *utils.UFSToUpdate) (err
 		e.updateMountTime()
 	}
 
+	if err := e.syncMountPointStats(); err != nil {
+		// just report this error and ignore it because storage accounting isn't on the critical path of Setup
+		e.Log.Error(err, "syncMountPointStats", "dataset", e.name)
+	}
+
 	return nil
 }
 
-// mountUFS() mount all UFSs to Alluxio according to mount points in `dataset.Spec`. If a mount point is Fluid-native, mountUFS() will skip it.
+// mountPlanStep describes a single UFS mount that phase 1 of mountUFS() has validated and
+// phase 2 still needs to apply.
+type mountPlanStep struct {
+	mount       datav1alpha1.Mount
+	alluxioPath string
+	options     map[string]string
+}
+
+// mountUFS() mount all UFSs to Alluxio according to mount points in `dataset.Spec`. If a mount
+// point is Fluid-native, mountUFS() will skip it.
+//
+// This is a two-phase apply: phase 1 validates every not-yet-mounted UFS (rendering its options,
+// resolving its secrets, checking IsMounted) and builds a plan; phase 2 executes the plan and, if
+// any step fails, unmounts everything this call newly mounted before returning. A rollback
+// unmount that itself fails is logged and that UFS stays mounted.
 func (e *AlluxioEngine) mountUFS() (err error) {
 	dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
 	if err != nil {
@@ -248,8 +487,9 @@ func (e *AlluxioEngine) mountUFS() (err error) {
 		return fmt.Errorf("the UFS is not ready")
 	}
 
-	everMounted := false
-	// Iterate all the mount points, do mount if the mount point is not Fluid-native(e.g. Hostpath or PVC)
+	// Phase 1: validate every mount point that is not Fluid-native(e.g. Hostpath or PVC) and
+	// build a plan. Nothing is mounted yet at this point.
+	var plan []mountPlanStep
 	for _, mount := range dataset.Spec.Mounts {
 		mount := mount
 		if common.IsFluidNativeScheme(mount.MountPoint) {
@@ -263,27 +503,149 @@ func (e *AlluxioEngine) mountUFS() (err error) {
 		if err != nil {
 			return err
 		}
+		if mounted {
+			continue
+		}
 
 		mOptions, err := e.genUFSMountOptions(mount)
 		if err != nil {
 			return errors.Wrapf(err, "gen ufs mount options by spec mount item failure,mount name:%s", mount.Name)
 		}
 
-		if !mounted {
-			err = fileUitls.Mount(alluxioPath, mount.MountPoint, mOptions, mount.ReadOnly, mount.Shared)
-			if err != nil {
-				return err
+		plan = append(plan, mountPlanStep{mount: mount, alluxioPath: alluxioPath, options: mOptions})
+	}
+
+	if len(plan) == 0 {
+		return nil
+	}
+
+	// Phase 2: execute the plan. On any error, unmount everything this call newly mounted
+	// before returning.
+	results, applyErr := e.applyMountPlan(fileUitls, plan)
+
+	e.recordMountPlanOutcome(results, applyErr)
+
+	if applyErr != nil {
+		return applyErr
+	}
+
+	e.updateMountTime()
+
+	if err := e.syncMountPointStats(); err != nil {
+		// just report this error and ignore it because storage accounting isn't on the critical path of Setup
+		e.Log.Error(err, "syncMountPointStats", "dataset", e.name)
+	}
+
+	return nil
+}
+
+// mountStepOutcome is the terminal state of a single mountPlanStep after applyMountPlan has run.
+type mountStepOutcome int
+
+const (
+	// mountStepSkipped means applyMountPlan never reached this step because an earlier step failed.
+	mountStepSkipped mountStepOutcome = iota
+	mountStepSucceeded
+	mountStepFailed
+	mountStepRolledBack
+)
+
+// mountPlanResult records what happened to a single mountPlanStep.
+type mountPlanResult struct {
+	step    mountPlanStep
+	outcome mountStepOutcome
+}
+
+// applyMountPlan executes a validated mount plan step by step. It returns one mountPlanResult per
+// plan step, in plan order, recording whether each step succeeded, failed, was rolled back, or was
+// never attempted. If a step fails, every step already mounted in this call is unmounted (in
+// reverse order) before returning the original error. A step whose unmount fails is logged, keeps
+// the outcome mountStepSucceeded and stays mounted, so only when every unmount succeeds is the
+// caller left with exactly the set of mounts that existed before mountUFS() was called.
+func (e *AlluxioEngine) applyMountPlan(fileUitls operations.AlluxioFileUtils, plan []mountPlanStep) ([]mountPlanResult, error) {
+	results := make([]mountPlanResult, len(plan))
+	for i, step := range plan {
+		results[i] = mountPlanResult{step: step, outcome: mountStepSkipped}
+	}
+
+	for i, step := range plan {
+		e.Log.Info("Mounting ufs", "mount", step.mount.Name, "alluxioPath", step.alluxioPath)
+		if err := fileUitls.Mount(step.alluxioPath, step.mount.MountPoint, step.options, step.mount.ReadOnly, step.mount.Shared); err != nil {
+			results[i].outcome = mountStepFailed
+
+			rolledBack := 0
+			for j := i - 1; j >= 0; j-- {
+				if unmountErr := fileUitls.UnMount(results[j].step.alluxioPath); unmountErr != nil {
+					e.Log.Error(unmountErr, "Failed to roll back mount, manual intervention may be required",
+						"mount", results[j].step.mount.Name, "alluxioPath", results[j].step.alluxioPath)
+					continue
+				}
+				results[j].outcome = mountStepRolledBack
+				rolledBack++
 			}
 
-			everMounted = true
+			e.Log.Error(err, "Mount step failed, rolled back previously mounted steps in this call",
+				"mount", step.mount.Name, "alluxioPath", step.alluxioPath, "rollbackCount", rolledBack)
+
+			return results, errors.Wrapf(err, "failed to mount ufs, mount name:%s, rolled back %d previously mounted ufs in this call", step.mount.Name, rolledBack)
 		}
+
+		results[i].outcome = mountStepSucceeded
 	}
 
-	if everMounted {
-		e.updateMountTime()
+	return results, nil
+}
+
+// recordMountPlanOutcome emits a Kubernetes Event per planned mount step and records the
+// plan/outcome as a status condition on the runtime, so operators can see which mount failed
+// and whether rollback completed without needing to inspect the master pod.
+func (e *AlluxioEngine) recordMountPlanOutcome(results []mountPlanResult, applyErr error) {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		e.Log.Error(err, "recordMountPlanOutcome: failed to get runtime", "name", e.name)
+		return
 	}
 
-	return nil
+	rolledBack := 0
+	for _, result := range results {
+		switch result.outcome {
+		case mountStepSucceeded:
+			e.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.MountSucceed, "Mounted ufs %s at %s", result.step.mount.Name, result.step.alluxioPath)
+		case mountStepFailed:
+			e.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.MountFailed, "Failed to mount ufs %s at %s: %v", result.step.mount.Name, result.step.alluxioPath, applyErr)
+		case mountStepRolledBack:
+			rolledBack++
+			e.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.MountFailed, "Rolled back ufs %s at %s after a later mount in the same call failed", result.step.mount.Name, result.step.alluxioPath)
+		case mountStepSkipped:
+			e.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.MountFailed, "Skipped mounting ufs %s at %s because an earlier mount in the same call failed", result.step.mount.Name, result.step.alluxioPath)
+		}
+	}
+
+	condStatus := corev1.ConditionTrue
+	reason := common.MountSucceed
+	message := fmt.Sprintf("successfully mounted %d ufs", len(results))
+	if applyErr != nil {
+		condStatus = corev1.ConditionFalse
+		reason = common.MountFailed
+		message = fmt.Sprintf("failed to apply mount plan of %d ufs, rolled back %d previously mounted ufs in this call: %v", len(results), rolledBack, applyErr)
+	}
+
+	cond := utils.NewRuntimeCondition(datav1alpha1.RuntimeUFSMounted, reason, message, condStatus)
+
+	retryErr := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtimeToUpdate, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+
+		runtimeToUpdate = runtimeToUpdate.DeepCopy()
+		runtimeToUpdate.Status.Conditions = utils.UpdateRuntimeCondition(runtimeToUpdate.Status.Conditions, cond)
+
+		return e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+	})
+	if retryErr != nil {
+		e.Log.Error(retryErr, "recordMountPlanOutcome: failed to update runtime status condition", "name", e.name)
+	}
 }
 
 // alluxio mount options
@@ -297,24 +659,47 @@ func (e *AlluxioEngine) genUFSMountOptions(m datav1alpha1.Mount) (map[string]str
 
 	// if encryptOptions have the same key with options
 	// it will overwrite the corresponding value
+	resolver := credentials.NewResolver()
 	for _, item := range m.EncryptOptions {
-
-		sRef := item.ValueFrom.SecretKeyRef
-		secret, err := kubeclient.GetSecret(e.Client, sRef.Name, e.namespace)
+		v, err := resolver.Resolve(context.TODO(), e.Client, e.namespace, e.podFileReader(), item)
 		if err != nil {
-			e.Log.Error(err, "get secret by mount encrypt options failed", "name", item.Name)
+			e.Log.Error(err, "resolve mount encrypt option failed", "name", item.Name)
 			return mOptions, err
 		}
 
-		e.Log.Info("get value from secret", "mount name", m.Name, "secret key", sRef.Key)
+		e.Log.Info("resolved value for encrypt option", "mount name", m.Name, "option name", item.Name)
 
-		v := secret.Data[sRef.Key]
-		mOptions[item.Name] = string(v)
+		mOptions[item.Name] = v
 	}
 
 	return mOptions, nil
 }
 
+// podFileReader returns a credentials.PodFileReader backed by the Alluxio master pod, used to
+// resolve EncryptOptions.ValueFrom.FileRef sources.
+func (e *AlluxioEngine) podFileReader() credentials.PodFileReader {
+	podName, containerName := e.getMasterPodInfo()
+	fileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+	return fileUtils
+}
+
+// mountOptionsDrifted reports whether the desired options/credentials/read-write-mode for a UFS
+// mount point differ from what Alluxio currently has mounted at alluxioPath, e.g. because
+// mount.Options was edited, ReadOnly/Shared was flipped, or a Secret referenced by
+// EncryptOptions was rotated.
+func (e *AlluxioEngine) mountOptionsDrifted(fileUtils operations.AlluxioFileUtils, alluxioPath string, mount datav1alpha1.Mount, desiredOptions map[string]string) (bool, error) {
+	info, err := fileUtils.GetMountInfo(alluxioPath)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get mount info, alluxioPath:%s", alluxioPath)
+	}
+
+	if info.ReadOnly != mount.ReadOnly || info.Shared != mount.Shared {
+		return true, nil
+	}
+
+	return !reflect.DeepEqual(info.Properties, desiredOptions), nil
+}
+
 func (e *AlluxioEngine) updateMountTime() {
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		runtime, err := e.getRuntime()
@@ -0,0 +1,103 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"reflect"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// UFSToUpdate holds the Alluxio paths that need to be mounted, unmounted or remounted to bring
+// Alluxio's mounted UFSs in line with a Dataset's current spec.
+type UFSToUpdate struct {
+	toAdd    []string
+	toRemove []string
+	toUpdate []string
+}
+
+// ToAdd returns the Alluxio paths of mounts that are new in the current spec, i.e. absent from
+// the previous one. Whether Alluxio already has them mounted is not checked.
+func (u *UFSToUpdate) ToAdd() []string {
+	return u.toAdd
+}
+
+// ToRemove returns the Alluxio paths of mounts that existed in the previous spec but are no
+// longer present in the current one.
+func (u *UFSToUpdate) ToRemove() []string {
+	return u.toRemove
+}
+
+// ToUpdate returns the Alluxio paths of mounts that are present in both specs but whose
+// Options, read-only/shared mode, or EncryptOptions have changed and so need a
+// remount to take effect.
+func (u *UFSToUpdate) ToUpdate() []string {
+	return u.toUpdate
+}
+
+// BuildUFSToUpdate diffs oldMounts against newMounts and returns the UFSToUpdate describing what
+// changed. alluxioPath maps a Mount to the path it is (or would be) mounted at in Alluxio, given
+// the full mount set it belongs to, matching UFSPathBuilder.GenAlluxioMountPath's signature.
+func BuildUFSToUpdate(oldMounts []datav1alpha1.Mount, newMounts []datav1alpha1.Mount, alluxioPath func(mount datav1alpha1.Mount, mounts []datav1alpha1.Mount) string) *UFSToUpdate {
+	oldByName := make(map[string]datav1alpha1.Mount, len(oldMounts))
+	for _, m := range oldMounts {
+		oldByName[m.Name] = m
+	}
+
+	newByName := make(map[string]datav1alpha1.Mount, len(newMounts))
+	for _, m := range newMounts {
+		newByName[m.Name] = m
+	}
+
+	result := &UFSToUpdate{}
+
+	for _, newMount := range newMounts {
+		path := alluxioPath(newMount, newMounts)
+
+		oldMount, existed := oldByName[newMount.Name]
+		if !existed {
+			result.toAdd = append(result.toAdd, path)
+			continue
+		}
+
+		if mountChanged(oldMount, newMount) {
+			result.toUpdate = append(result.toUpdate, path)
+		}
+	}
+
+	for _, oldMount := range oldMounts {
+		if _, stillPresent := newByName[oldMount.Name]; !stillPresent {
+			result.toRemove = append(result.toRemove, alluxioPath(oldMount, oldMounts))
+		}
+	}
+
+	return result
+}
+
+// mountChanged reports whether a Mount's Options, access mode or credential references
+// differ between its old and new spec, i.e. whether Alluxio needs to remount it to pick up the
+// change.
+func mountChanged(oldMount datav1alpha1.Mount, newMount datav1alpha1.Mount) bool {
+	if oldMount.ReadOnly != newMount.ReadOnly || oldMount.Shared != newMount.Shared {
+		return true
+	}
+
+	if !reflect.DeepEqual(oldMount.Options, newMount.Options) {
+		return true
+	}
+
+	return !reflect.DeepEqual(oldMount.EncryptOptions, newMount.EncryptOptions)
+}
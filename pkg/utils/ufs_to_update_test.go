@@ -0,0 +1,117 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestMountChanged(t *testing.T) {
+	base := datav1alpha1.Mount{
+		Name:     "foo",
+		ReadOnly: false,
+		Shared:   false,
+		Options:  map[string]string{"fs.oss.endpoint": "oss.example.com"},
+	}
+
+	tests := []struct {
+		name string
+		old  datav1alpha1.Mount
+		new  datav1alpha1.Mount
+		want bool
+	}{
+		{name: "identical", old: base, new: base, want: false},
+		{
+			name: "read-only flipped",
+			old:  base,
+			new:  func() datav1alpha1.Mount { m := base; m.ReadOnly = true; return m }(),
+			want: true,
+		},
+		{
+			name: "shared flipped",
+			old:  base,
+			new:  func() datav1alpha1.Mount { m := base; m.Shared = true; return m }(),
+			want: true,
+		},
+		{
+			name: "options changed",
+			old:  base,
+			new:  func() datav1alpha1.Mount { m := base; m.Options = map[string]string{"fs.oss.endpoint": "oss2.example.com"}; return m }(),
+			want: true,
+		},
+		{
+			name: "encrypt options added",
+			old:  base,
+			new: func() datav1alpha1.Mount {
+				m := base
+				m.EncryptOptions = []datav1alpha1.EncryptOption{{Name: "fs.oss.accessKeyId", ValueFrom: datav1alpha1.EncryptOptionSource{SecretKeyRef: nil}}}
+				return m
+			}(),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mountChanged(tt.old, tt.new); got != tt.want {
+				t.Errorf("mountChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildUFSToUpdate(t *testing.T) {
+	alluxioPath := func(m datav1alpha1.Mount, mounts []datav1alpha1.Mount) string {
+		return "/mnt/" + m.Name
+	}
+
+	oldMounts := []datav1alpha1.Mount{
+		{Name: "unchanged", Options: map[string]string{"a": "1"}},
+		{Name: "changed", Options: map[string]string{"a": "1"}},
+		{Name: "removed", Options: map[string]string{"a": "1"}},
+	}
+	newMounts := []datav1alpha1.Mount{
+		{Name: "unchanged", Options: map[string]string{"a": "1"}},
+		{Name: "changed", Options: map[string]string{"a": "2"}},
+		{Name: "added", Options: map[string]string{"a": "1"}},
+	}
+
+	got := BuildUFSToUpdate(oldMounts, newMounts, alluxioPath)
+
+	if want := []string{"/mnt/added"}; !stringSliceEqual(got.ToAdd(), want) {
+		t.Errorf("ToAdd() = %v, want %v", got.ToAdd(), want)
+	}
+	if want := []string{"/mnt/changed"}; !stringSliceEqual(got.ToUpdate(), want) {
+		t.Errorf("ToUpdate() = %v, want %v", got.ToUpdate(), want)
+	}
+	if want := []string{"/mnt/removed"}; !stringSliceEqual(got.ToRemove(), want) {
+		t.Errorf("ToRemove() = %v, want %v", got.ToRemove(), want)
+	}
+}
+
+func stringSliceEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}